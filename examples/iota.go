@@ -0,0 +1,45 @@
+// Go iota Test File
+package main
+
+// Plain enum with a skipped value
+type Weekday int
+
+const (
+	_ Weekday = iota // skip zero so the default value is invalid
+	Monday
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+)
+
+func (d Weekday) String() string {
+	return [...]string{"", "Mon", "Tue", "Wed", "Thu", "Fri"}[d]
+}
+
+// Shifted flag enum
+type Permission uint8
+
+const (
+	PermRead Permission = 1 << iota
+	PermWrite
+	PermExec
+	PermAll = PermRead | PermWrite | PermExec
+)
+
+// Byte sizes with an expression on iota
+const (
+	_  = iota
+	KB = 1 << (10 * iota)
+	MB
+	GB
+)
+
+// Typed string enum
+type Level string
+
+const (
+	LevelDebug Level = "debug"
+	LevelInfo  Level = "info"
+	LevelError Level = "error"
+)
@@ -0,0 +1,17 @@
+# Chef Recipe Test File
+package 'nginx' do
+  action :install
+end
+
+template '/etc/nginx/sites-available/users' do
+  source 'users.conf.erb'
+  owner 'root'
+  mode '0644'
+  variables(port: node['users']['port'], workers: 4)
+  notifies :reload, 'service[nginx]', :delayed
+end
+
+service 'nginx' do
+  action %i(enable start)
+  only_if { ::File.exist?('/etc/nginx/nginx.conf') }
+end
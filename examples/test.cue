@@ -0,0 +1,32 @@
+// CUE Test File
+package users
+
+import "strings"
+
+#Role: "admin" | "user" | *"guest"
+
+#User: {
+	id:     int & >0
+	name:   string & strings.MinRunes(1)
+	email:  =~"^[^@]+@[^@]+$"
+	active: bool | *true
+	roles: [...#Role]
+	createdAt?: string
+}
+
+#Service: {
+	port:    int & >=1024 & <=65535 | *8080
+	timeout: "\(seconds)s"
+	seconds: 30
+}
+
+users: [ID=string]: #User & {id: >0}
+
+users: jane: {
+	id:    1
+	name:  "Jane Doe"
+	email: "jane@example.com"
+	roles: ["admin"]
+}
+
+service: #Service & {port: 9090}
@@ -0,0 +1,38 @@
+# Gherkin Test File
+@users @smoke
+Feature: User management
+  As an administrator
+  I want to manage user accounts
+  So that only active users can sign in
+
+  Background:
+    Given the user service is running
+    And the database is empty
+
+  @happy-path
+  Scenario: Create a new user
+    Given an administrator is signed in
+    When they create a user named "Jane Doe" with email "jane@example.com"
+    Then the user list contains 1 user
+    And the response code is 201
+
+  @regression
+  Scenario Outline: Reject invalid users
+    Given an administrator is signed in
+    When they create a user named "<name>" with role <role>
+    Then the request fails with "<error>"
+
+    Examples:
+      | name     | role  | error               |
+      | Jane Doe | admin | user already exists |
+      |          | guest | name is required    |
+      | John     | owner | unknown role        |
+
+  Scenario: Import users from JSON
+    Given the following payload:
+      """json
+      { "name": "Jane Doe", "active": true }
+      """
+    When the payload is imported
+    Then the user "Jane Doe" is active
+    But no email is sent
@@ -0,0 +1,44 @@
+# Fish Shell Test File
+set -x EDITOR code
+set -gx PATH $HOME/.local/bin $PATH
+set -l project_dir (pwd)
+set --universal greeting "Hello"
+
+function greet --description 'Greet a user'
+    set -l name $argv[1]
+    if test -z "$name"
+        set name (whoami)
+    end
+    echo "$greeting, $name!"  # double quotes expand variables
+    echo '$greeting stays literal'  # single quotes do not
+end
+
+function count_files
+    set -l total (count (ls $argv))
+    if test $total -gt 10
+        echo "many files: $total"
+    else if test $total -eq 0
+        echo "no files"
+    else
+        echo "few files"
+    end
+end
+
+for file in *.go
+    switch $file
+        case '*_test.go'
+            continue
+        case '*'
+            echo "building $file"
+    end
+end
+
+while read -l line
+    string match -q -r '^#' -- $line; and continue
+    echo $line | string upper
+end < config.txt
+
+begin
+    set -l status_code (curl -s -o /dev/null -w '%{http_code}' https://example.com)
+    test $status_code = 200; or return 1
+end
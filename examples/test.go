@@ -10,6 +10,9 @@ import (
 	"net/http"
 	"sync"
 	"time"
+
+	_ "embed"
+	stdlog "log/slog"
 )
 
 // Constants
@@ -24,8 +27,8 @@ const (
 type User struct {
 	ID        int       `json:"id"`
 	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Active    bool      `json:"active"`
+	Email     string    `json:"email,omitempty" db:"email"`
+	Active    bool      `json:"active" db:"is_active"`
 	Roles     []string  `json:"roles"`
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -52,7 +55,12 @@ type userService struct {
 	config Config
 }
 
-// Constructor function
+// NewUserService returns an in-memory UserService.
+//
+// ```go
+// svc := NewUserService(Config{Port: 8080})
+// user, err := svc.FindUser(ctx, 42)
+// ```
 func NewUserService(config Config) UserService {
 	return &userService{
 		users:  make(map[int]*User),
@@ -60,6 +68,13 @@ func NewUserService(config Config) UserService {
 	}
 }
 
+// NewService creates a user service.
+//
+// Deprecated: Use NewUserService instead.
+func NewService(config Config) UserService {
+	return NewUserService(config)
+}
+
 // Methods
 func (s *userService) FindUser(ctx context.Context, id int) (*User, error) {
 	s.mu.RLock()
@@ -215,6 +230,8 @@ func processValue(val interface{}) {
 		fmt.Printf("String: %s\n", v)
 	case User:
 		fmt.Printf("User: %+v\n", v)
+	case error, []byte:
+		fmt.Printf("Error or bytes: %v\n", v)
 	default:
 		fmt.Printf("Unknown type: %T\n", v)
 	}
@@ -230,6 +247,15 @@ func sum(numbers ...int) int {
 }
 
 // Closures
+//
+// counter returns a closure over its own count:
+//
+// ```go
+// next := counter()
+// for range 3 {
+// 	fmt.Println(next())
+// }
+// ```
 func counter() func() int {
 	count := 0
 	return func() int {
@@ -272,6 +298,43 @@ func Filter[T any](slice []T, fn func(T) bool) []T {
 	return result
 }
 
+// Field vs method on the same receiver
+type Counter struct {
+	count int
+}
+
+func (c *Counter) Count() int {
+	return c.count
+}
+
+func (c *Counter) Increment() {
+	c.count = c.Count() + 1
+}
+
+// Type constraints
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Sum[T Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+type Set[K comparable] map[K]struct{}
+
+// Grouped generic type declarations
+type (
+	Pair[K comparable, V any] struct {
+		Key   K
+		Value V
+	}
+	List[T Number] []T
+)
+
 // Main function
 func main() {
 	config := Config{
@@ -279,11 +342,24 @@ func main() {
 		Timeout: Timeout,
 	}
 	
+	// Struct literal keys are fields, map literal keys are plain values
+	limits := map[string]int{"users": MaxUsers, "port": DefaultPort}
+	codes := map[int]string{200: "ok", 404: "not found"}
+	admin := User{ID: 1, Name: "admin", Roles: []string{"admin"}}
+	_, _, _ = limits, codes, admin
+
+	// Variadic spread vs. array length inferred from the literal
+	weekdays := [...]string{"mon", "tue", "wed", "thu", "fri"}
+	nums := []int{1, 2, 3}
+	_, _ = weekdays, sum(nums...)
+
 	service := NewUserService(config)
+	_ = NewService(config)
 	handler := NewUserHandler(service)
 	
 	http.HandleFunc("/users", handler.GetUser)
 	
+	stdlog.Info("starting server", "port", config.Port, "version", APIVersion)
 	log.Printf("Starting server on port %d", config.Port)
 	if err := http.ListenAndServe(fmt.Sprintf(":%d", config.Port), nil); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,35 @@
+(* OCaml Interface Test File *)
+
+type role = Admin | User | Guest
+
+type user = {
+  id : int;
+  name : string;
+  roles : role list;
+  email : string option;
+}
+
+(** Errors returned by the service *)
+type error =
+  [ `Not_found of int
+  | `Already_exists of string ]
+
+val find : user list -> int -> (user, error) result
+val create : ?active:bool -> name:string -> unit -> user
+val map_names : (string -> 'a) -> user list -> 'a list
+
+module type STORE = sig
+  type 'a t
+  val empty : 'a t
+  val add : int -> 'a -> 'a t -> 'a t
+  val find_opt : int -> 'a t -> 'a option
+end
+
+module Make (Store : STORE) : sig
+  type t
+  val users : t -> user Store.t
+end
+
+module type FUNCTOR = functor (S : STORE) -> sig
+  val size : 'a S.t -> int
+end
@@ -0,0 +1,56 @@
+// Move Test File
+/// A simple coin vault using Move resources.
+module 0x1::vault {
+    use std::signer;
+    use aptos_std::table::{Self, Table};
+
+    /* Error codes */
+    const E_NOT_FOUND: u64 = 1;
+    const E_INSUFFICIENT: u64 = 2;
+
+    struct Coin<phantom T> has store, drop {
+        value: u64,
+    }
+
+    struct Vault<phantom T> has key {
+        balance: Coin<T>,
+        owners: Table<address, bool>,
+    }
+
+    public fun zero<T>(): Coin<T> {
+        Coin<T> { value: 0 }
+    }
+
+    public entry fun open<T>(account: &signer) {
+        let vault = Vault<T> { balance: zero<T>(), owners: table::new() };
+        move_to(account, vault);
+    }
+
+    public fun deposit<T>(addr: address, coin: Coin<T>) acquires Vault {
+        let vault = borrow_global_mut<Vault<T>>(addr);
+        let Coin { value } = coin;
+        vault.balance.value = vault.balance.value + value;
+    }
+
+    public fun withdraw<T>(account: &signer, amount: u64): Coin<T> acquires Vault {
+        let addr = signer::address_of(account);
+        assert!(exists<Vault<T>>(addr), E_NOT_FOUND);
+        let vault = borrow_global_mut<Vault<T>>(addr);
+        assert!(vault.balance.value >= amount, E_INSUFFICIENT);
+        vault.balance.value = vault.balance.value - amount;
+        Coin<T> { value: amount }
+    }
+
+    public fun close<T>(account: &signer): Coin<T> acquires Vault {
+        let Vault { balance, owners } = move_from<Vault<T>>(signer::address_of(account));
+        table::drop_unchecked(owners);
+        let copied = copy balance;
+        move copied
+    }
+
+    #[test(admin = @0x1)]
+    fun test_open(admin: signer) {
+        open<u64>(&admin);
+        assert!(exists<Vault<u64>>(@0x1), 0);
+    }
+}
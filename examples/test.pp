@@ -0,0 +1,37 @@
+# Puppet Test File
+class users (
+  String  $admin_name = 'admin',
+  Integer $max_users  = 100,
+  Boolean $manage_db  = true,
+) {
+  $config_dir = '/etc/users'
+
+  package { 'users-service':
+    ensure => installed,
+  }
+
+  file { "${config_dir}/config.yaml":
+    ensure  => file,
+    owner   => $admin_name,
+    mode    => '0640',
+    content => @("CONFIG"),
+      max_users: ${max_users}
+      admin: ${admin_name}
+      | CONFIG
+    require => Package['users-service'],
+  }
+
+  if $manage_db {
+    include users::database
+  }
+
+  service { 'users-service':
+    ensure    => running,
+    enable    => true,
+    subscribe => File["${config_dir}/config.yaml"],
+  }
+}
+
+define users::account (String $role = 'user') {
+  notify { "Creating ${title} with role ${role}": }
+}
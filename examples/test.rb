@@ -0,0 +1,36 @@
+# Ruby Test File
+require 'json'
+
+module Users
+  ROLES = %i[admin user guest].freeze
+
+  class User
+    attr_reader :id, :name, :roles
+
+    @@count = 0
+
+    def initialize(id:, name:, roles: [:user])
+      @id = id
+      @name = name
+      @roles = roles
+      @@count += 1
+    end
+
+    def admin? = roles.include?(:admin)
+
+    def to_h
+      { id: @id, name: @name, roles: @roles }
+    end
+
+    def self.count
+      @@count
+    end
+  end
+
+  def self.find(users, id)
+    users.find { |u| u.id == id } || raise(ArgumentError, "user #{id} not found")
+  end
+end
+
+user = Users::User.new(id: 1, name: 'Jane', roles: [:admin])
+puts user.to_h.to_json if user.admin?
@@ -0,0 +1,40 @@
+// Thrift Test File
+namespace go users
+namespace java com.example.users
+
+include "common.thrift"
+
+/* Base types, containers and typedefs */
+typedef i64 UserId
+typedef map<string, list<string>> Attributes
+
+const i32 MAX_USERS = 100
+const string API_VERSION = "1.0.0"
+
+enum Role {
+  ADMIN = 1,
+  USER = 2,
+  GUEST = 3,
+}
+
+struct User {
+  1: required UserId id,
+  2: required string name,
+  3: optional string email,
+  4: bool active = true,
+  5: list<Role> roles,
+  6: optional Attributes attributes,
+  7: common.Timestamp createdAt,
+}
+
+exception UserNotFound {
+  1: UserId id,
+  2: string message,
+}
+
+# Service definition
+service UserService extends common.BaseService {
+  User findUser(1: UserId id) throws (1: UserNotFound notFound),
+  void createUser(1: User user),
+  oneway void ping(),
+}
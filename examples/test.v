@@ -0,0 +1,50 @@
+// V Test File
+module main
+
+import os
+
+const max_users = 100
+
+pub enum Role {
+	admin
+	user
+	guest
+}
+
+pub struct User {
+pub:
+	id   int
+	name string
+pub mut:
+	active bool = true
+	roles  []Role
+}
+
+/* Returns an optional result */
+fn find_user(users []User, id int) !User {
+	for user in users {
+		if user.id == id {
+			return user
+		}
+	}
+	return error('user ${id} not found')
+}
+
+fn (mut u User) deactivate() {
+	u.active = false
+}
+
+fn main() {
+	mut users := [User{
+		id: 1
+		name: 'Jane'
+		roles: [.admin]
+	}]
+	user := find_user(users, 2) or {
+		eprintln('lookup failed: ${err}')
+		User{ id: 0, name: 'guest' }
+	}
+	users[0].deactivate()
+	ratio := 0.75
+	println('${user.name} (${users.len}/${max_users}) ${ratio * 100:.1f}% ${os.args.len}')
+}
@@ -0,0 +1,36 @@
+;; WebAssembly Text Format Test File
+(module $users
+  (; Imports and memory ;)
+  (import "env" "log" (func $log (param i32 i32)))
+  (memory $mem (export "memory") 1)
+  (data (i32.const 0) "user not found")
+
+  (global $count (mut i32) (i32.const 0))
+  (global $ratio f64 (f64.const 0.75))
+
+  (table $handlers 2 funcref)
+  (elem (i32.const 0) $add $count_users)
+
+  (type $binop (func (param i32 i32) (result i32)))
+
+  ;; Add two numbers
+  (func $add (export "add") (type $binop) (param $a i32) (param $b i32) (result i32)
+    local.get $a
+    local.get $b
+    i32.add)
+
+  (func $count_users (export "countUsers") (param $n i32) (result i32)
+    (local $i i32)
+    (block $done
+      (loop $next
+        (br_if $done (i32.ge_u (local.get $i) (local.get $n)))
+        (global.set $count (i32.add (global.get $count) (i32.const 1)))
+        (local.set $i (i32.add (local.get $i) (i32.const 1)))
+        (br $next)))
+    (if (i32.eqz (global.get $count))
+      (then (call $log (i32.const 0) (i32.const 14))))
+    global.get $count)
+
+  (func $dispatch (param $idx i32) (result i32)
+    (call_indirect (type $binop) (i32.const 2) (i32.const 0x10) (local.get $idx)))
+)
@@ -0,0 +1,57 @@
+// Zig Test File
+const std = @import("std");
+
+const UserError = error{
+    NotFound,
+    AlreadyExists,
+    InvalidName,
+};
+
+const User = struct {
+    id: u32,
+    name: []const u8,
+    active: bool = true,
+};
+
+fn Stack(comptime T: type, comptime capacity: usize) type {
+    return struct {
+        items: [capacity]T = undefined,
+        len: usize = 0,
+
+        const Self = @This();
+
+        pub fn push(self: *Self, item: T) error{Overflow}!void {
+            if (self.len == capacity) return error.Overflow;
+            self.items[self.len] = item;
+            self.len += 1;
+        }
+    };
+}
+
+fn findUser(users: []const User, id: u32) UserError!User {
+    for (users) |user| {
+        if (user.id == id) return user;
+    }
+    return UserError.NotFound;
+}
+
+pub fn main() !void {
+    const users = [_]User{
+        .{ .id = 1, .name = "Jane" },
+        .{ .id = 2, .name = "John", .active = false },
+    };
+
+    const user = findUser(&users, 1) catch |err| {
+        std.debug.print("lookup failed: {s}\n", .{@errorName(err)});
+        return err;
+    };
+
+    var stack = Stack(u32, 4){};
+    try stack.push(user.id);
+
+    outer: for (users) |u| {
+        if (!u.active) break :outer;
+    }
+
+    std.debug.print("{s} has size {d}\n", .{ user.name, @sizeOf(User) });
+}
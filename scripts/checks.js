@@ -0,0 +1,602 @@
+'use strict';
+
+// Theme checks shared by the node:test suite in test/. Each check takes a
+// variant from loadVariants() and returns { errors, warnings, notes }: errors
+// fail the check, warnings and notes are only reported.
+
+const fs = require('node:fs');
+const path = require('node:path');
+
+const { root, readJSON, duplicateKeys } = require('./theme');
+
+const BRACKET_LEVELS = [1, 2, 3, 4, 5, 6];
+const BRACKET_KEY = /^editorBracket(Highlight|PairGuide)\./;
+const PALETTE_FILE_KEYS = ['$schema', 'name', 'type', 'include', 'colors'];
+// Minimum RGB distance between any two bracket levels, and between a level
+// and the unexpected-bracket color.
+const MIN_BRACKET_DISTANCE = 32;
+
+const HEX_COLOR = /^#([0-9a-f]{3,4}|[0-9a-f]{6}|[0-9a-f]{8})$/i;
+
+// Workbench colors every variant must define. VS Code falls back to its
+// own defaults for a missing key, which are tuned for Dark+ and stand out
+// against this palette.
+const REQUIRED_COLORS = [
+  'foreground', 'focusBorder',
+  'editor.background', 'editor.foreground', 'editor.selectionBackground', 'editorCursor.foreground',
+  'editorLineNumber.foreground', 'editorLineNumber.activeForeground', 'editorGutter.background',
+  'editorWidget.background', 'editorSuggestWidget.background', 'editorHoverWidget.background',
+  'editorError.foreground', 'editorWarning.foreground',
+  ...BRACKET_LEVELS.flatMap((n) => [`editorIndentGuide.background${n}`, `editorIndentGuide.activeBackground${n}`]),
+  'activityBar.background', 'sideBar.background', 'statusBar.background',
+  'titleBar.activeBackground', 'titleBar.activeForeground', 'editorGroupHeader.tabsBackground',
+  'tab.activeBackground', 'tab.inactiveBackground', 'tab.inactiveForeground', 'panel.background',
+  'input.background', 'dropdown.background', 'quickInput.background', 'list.activeSelectionBackground',
+  'button.background', 'button.foreground', 'badge.background', 'badge.foreground', 'notifications.background',
+  'terminal.background', 'terminal.foreground',
+];
+const ANSI_COLORS = ['Black', 'Red', 'Green', 'Yellow', 'Blue', 'Magenta', 'Cyan', 'White'].flatMap((name) => [
+  `terminal.ansi${name}`,
+  `terminal.ansiBright${name}`,
+]);
+
+// TextMate scope selectors as themes use them: space-separated scope names,
+// each optionally negated with a leading "-".
+const SCOPE_SELECTOR = /^-?\w[\w+-]*(\.[\w+-]+)*(\s+-?\w[\w+-]*(\.[\w+-]+)*)*$/;
+const FONT_STYLE = /^(\s*(italic|bold|underline|strikethrough))*\s*$/;
+
+// WCAG contrast minimums: 4.5:1 for text, 3:1 for UI elements such as
+// inactive line numbers and tab labels. Text on a color not listed here
+// is assumed to use the workbench foreground.
+const MIN_TEXT_CONTRAST = 4.5;
+const MIN_UI_CONTRAST = 3;
+const CONTRAST_PAIRS = [
+  ['editor.foreground', 'editor.background', MIN_TEXT_CONTRAST],
+  ['editorLineNumber.activeForeground', 'editor.background', MIN_TEXT_CONTRAST],
+  ['editorLineNumber.foreground', 'editor.background', MIN_UI_CONTRAST],
+  ...['activityBar.background', 'sideBar.background', 'statusBar.background', 'tab.activeBackground',
+    'panel.background', 'input.background', 'editorWidget.background', 'notifications.background',
+  ].map((background) => ['foreground', background, MIN_TEXT_CONTRAST]),
+  ['titleBar.activeForeground', 'titleBar.activeBackground', MIN_TEXT_CONTRAST],
+  ['tab.inactiveForeground', 'tab.inactiveBackground', MIN_UI_CONTRAST],
+  ['button.foreground', 'button.background', MIN_TEXT_CONTRAST],
+  ['badge.foreground', 'badge.background', MIN_TEXT_CONTRAST],
+  ['debugConsole.sourceForeground', 'panel.background', MIN_TEXT_CONTRAST],
+  ['terminal.foreground', 'terminal.background', MIN_TEXT_CONTRAST],
+  // ansiBlack is a background color in most programs and is left out.
+  ...ANSI_COLORS.filter((key) => key !== 'terminal.ansiBlack').map((key) => [key, 'terminal.background', MIN_TEXT_CONTRAST]),
+];
+
+// What VS Code's theme service accepts structurally: the theme types it
+// knows and the keys it reads from a tokenColors settings object.
+const THEME_TYPES = ['dark', 'light', 'hc-dark', 'hc-light'];
+const TOKEN_SETTINGS_KEYS = ['foreground', 'background', 'fontStyle'];
+
+// type(.modifier)*(:language), where type may be "*".
+const SEMANTIC_SELECTOR = /^(\*|[A-Za-z][\w-]*)((?:\.[A-Za-z][\w-]*)*)(?::([A-Za-z][\w-]*))?$/;
+const SEMANTIC_STYLE_KEYS = ['foreground', 'fontStyle', 'bold', 'italic', 'underline', 'strikethrough'];
+
+// Token types and modifiers from the VS Code standard legend, plus the
+// extra ones reported by the language servers the theme targets.
+const SEMANTIC_TOKEN_TYPES = [
+  'namespace', 'class', 'enum', 'interface', 'struct', 'typeParameter', 'type',
+  'parameter', 'variable', 'property', 'enumMember', 'decorator', 'event',
+  'function', 'method', 'macro', 'label', 'comment', 'string', 'keyword',
+  'number', 'regexp', 'operator',
+];
+const SEMANTIC_TOKEN_MODIFIERS = [
+  'declaration', 'definition', 'readonly', 'static', 'deprecated', 'abstract',
+  'async', 'modification', 'documentation', 'defaultLibrary',
+  'local', // TypeScript
+  'decorator', // Pylance
+];
+const LANGUAGE_TOKEN_TYPES = {
+  zig: ['errorTag', 'builtin', 'keywordLiteral'],
+};
+const LANGUAGE_TOKEN_MODIFIERS = {
+  zig: ['generic'],
+};
+
+// Token colors that must stay apart in brightness, not only in hue, so they
+// remain distinguishable for color-vision deficiencies and low vision. Each
+// role is looked up through the theme's TextMate rules by a typical scope.
+const TOKEN_ROLES = {
+  string: 'string.quoted.double',
+  number: 'constant.numeric',
+  property: 'variable.other.property',
+  keyword: 'keyword.control',
+  type: 'entity.name.type',
+  function: 'entity.name.function',
+  variable: 'variable.other',
+  operator: 'keyword.operator',
+  comment: 'comment.line',
+  'exceptional call': 'support.function.builtin.exceptional.go',
+};
+const LUMINANCE_PAIRS = [
+  ['number', 'string'],
+  ['number', 'property'],
+  ['number', 'keyword'],
+  ['number', 'exceptional call'],
+  ['keyword', 'type'],
+];
+const MIN_LUMINANCE_DELTA = 0.05;
+
+// Distinct colors per variant, alpha ignored. Palette variants may add one
+// color per bracket level on top of the base budget.
+const COLOR_BUDGET = 35;
+const BRACKET_PALETTE_ALLOWANCE = BRACKET_LEVELS.length;
+const NEAR_DUPLICATE_DISTANCE = 6;
+
+function opaque(color) {
+  return color.toLowerCase().slice(0, 7);
+}
+
+// Bracket palettes: the six levels must stay at least MIN_BRACKET_DISTANCE
+// apart from each other and from the unexpected-bracket color, and a palette variant may only
+// override bracket keys on top of the theme it includes.
+function bracketPalette(variant) {
+  const errors = [];
+  const colors = variant.theme.colors;
+
+  const named = BRACKET_LEVELS.map((n) => [`level ${n}`, colors[`editorBracketHighlight.foreground${n}`]]);
+  const unexpected = colors['editorBracketHighlight.unexpectedBracket.foreground'];
+  if (unexpected) {
+    named.push(['the unexpected-bracket color', unexpected]);
+  }
+  const defined = named.filter(([, color]) => color);
+  defined.forEach(([a, x], i) => {
+    for (const [b, y] of defined.slice(i + 1)) {
+      const distance = rgbDistance(opaque(x), opaque(y));
+      if (distance < MIN_BRACKET_DISTANCE) {
+        errors.push(`${a} ${x} and ${b} ${y} are only ${distance.toFixed(1)} apart (minimum ${MIN_BRACKET_DISTANCE})`);
+      }
+    }
+  });
+
+  if (variant.raw.include) {
+    for (const key of Object.keys(variant.raw)) {
+      if (!PALETTE_FILE_KEYS.includes(key)) {
+        errors.push(`palette variant defines "${key}"`);
+      }
+    }
+    for (const key of Object.keys(variant.raw.colors || {})) {
+      if (!BRACKET_KEY.test(key)) {
+        errors.push(`palette variant overrides non-bracket key ${key}`);
+      }
+    }
+  }
+
+  return { errors, warnings: [], notes: [] };
+}
+
+// Bracket colorization and bracket-pair guides can be toggled separately, so
+// each must define all six levels on its own. Guides must match the bracket
+// level they belong to: the active guide in the same color, the inactive one
+// in the same color with alpha.
+function bracketGuides(variant) {
+  const errors = [];
+  const colors = variant.theme.colors;
+  const keys = (n) => ({
+    bracket: `editorBracketHighlight.foreground${n}`,
+    guide: `editorBracketPairGuide.background${n}`,
+    activeGuide: `editorBracketPairGuide.activeBackground${n}`,
+  });
+
+  for (const n of BRACKET_LEVELS) {
+    const level = keys(n);
+    const missing = Object.values(level).filter((key) => !colors[key]);
+    if (missing.length) {
+      missing.forEach((key) => errors.push(`${key} is not defined`));
+      continue;
+    }
+    if (opaque(colors[level.activeGuide]) !== opaque(colors[level.bracket])) {
+      errors.push(`${level.activeGuide} ${colors[level.activeGuide]} does not match ${level.bracket} ${colors[level.bracket]}`);
+    }
+    if (opaque(colors[level.guide]) !== opaque(colors[level.bracket])) {
+      errors.push(`${level.guide} ${colors[level.guide]} does not match ${level.bracket} ${colors[level.bracket]}`);
+    } else if (colors[level.guide].length !== 9) {
+      errors.push(`${level.guide} ${colors[level.guide]} should be translucent`);
+    }
+  }
+  if (!colors['editorBracketHighlight.unexpectedBracket.foreground']) {
+    errors.push('editorBracketHighlight.unexpectedBracket.foreground is not defined');
+  }
+
+  return { errors, warnings: [], notes: [] };
+}
+
+function editDistance(a, b) {
+  const row = Array.from({ length: b.length + 1 }, (_, j) => j);
+  for (let i = 1; i <= a.length; i++) {
+    let diagonal = row[0];
+    row[0] = i;
+    for (let j = 1; j <= b.length; j++) {
+      const above = row[j];
+      row[j] = Math.min(row[j] + 1, row[j - 1] + 1, diagonal + (a[i - 1] === b[j - 1] ? 0 : 1));
+      diagonal = above;
+    }
+  }
+  return row[b.length];
+}
+
+function unknownName(kind, name, known) {
+  const close = known.find((candidate) => editDistance(name.toLowerCase(), candidate.toLowerCase()) <= 2);
+  return close ? `unknown ${kind} "${name}", did you mean "${close}"?` : `unknown ${kind} "${name}"`;
+}
+
+// semanticTokenColors: selectors must follow VS Code's selector grammar,
+// values must be hex colors or style objects, and no selector may be listed
+// twice in the same file. Unknown token types or modifiers are only warned
+// about, since language servers may define their own.
+function semanticSelectors(variant) {
+  const errors = [];
+  const warnings = [];
+
+  for (const [selector, value] of Object.entries(variant.raw.semanticTokenColors || {})) {
+    const match = SEMANTIC_SELECTOR.exec(selector);
+    if (!match) {
+      errors.push(`"${selector}" is not a valid selector`);
+      continue;
+    }
+
+    const [, type, modifierList, language] = match;
+    const types = [...SEMANTIC_TOKEN_TYPES, ...(LANGUAGE_TOKEN_TYPES[language] || [])];
+    const modifiers = [...SEMANTIC_TOKEN_MODIFIERS, ...(LANGUAGE_TOKEN_MODIFIERS[language] || [])];
+    if (type !== '*' && !types.includes(type)) {
+      warnings.push(`"${selector}": ${unknownName('token type', type, types)}`);
+    }
+    for (const modifier of modifierList.split('.').slice(1)) {
+      if (!modifiers.includes(modifier)) {
+        warnings.push(`"${selector}": ${unknownName('modifier', modifier, modifiers)}`);
+      }
+    }
+
+    if (typeof value === 'string') {
+      if (!HEX_COLOR.test(value)) {
+        errors.push(`"${selector}": "${value}" is not a hex color`);
+      }
+    } else if (value && typeof value === 'object' && !Array.isArray(value)) {
+      for (const [key, setting] of Object.entries(value)) {
+        if (!SEMANTIC_STYLE_KEYS.includes(key)) {
+          errors.push(`"${selector}": unknown style key "${key}"`);
+        } else if (key === 'foreground' && !HEX_COLOR.test(setting)) {
+          errors.push(`"${selector}": "${setting}" is not a hex color`);
+        } else if (key === 'fontStyle' && !FONT_STYLE.test(setting)) {
+          errors.push(`"${selector}": invalid fontStyle "${setting}"`);
+        } else if (!['foreground', 'fontStyle'].includes(key) && typeof setting !== 'boolean') {
+          errors.push(`"${selector}": "${key}" must be true or false`);
+        }
+      }
+    } else {
+      errors.push(`"${selector}": value must be a color or a style object`);
+    }
+  }
+
+  for (const at of duplicateKeys(variant.text)) {
+    if (at.length === 2 && at[0] === 'semanticTokenColors') {
+      errors.push(`"${at[1]}" is defined more than once`);
+    }
+  }
+
+  return { errors, warnings, notes: [] };
+}
+
+// A JSON path in the form the diagnostics print it: tokenColors[3].settings,
+// colors["editor.background"].
+function jsonPath(at) {
+  return at
+    .map((key, i) => {
+      if (typeof key === 'number') {
+        return `[${key}]`;
+      }
+      return /^[A-Za-z_$][\w$]*$/.test(key) ? `${i ? '.' : ''}${key}` : `[${JSON.stringify(key)}]`;
+    })
+    .join('');
+}
+
+// Structural mistakes VS Code's theme loader reports or silently drops: an
+// unknown type, an include that does not resolve, a color that is not hex,
+// a tokenColors rule without a settings object or with keys VS Code does
+// not read, and repeated keys. Also checks that every contributed grammar
+// declares the scopeName package.json registers it under. Each error starts
+// with the JSON path it refers to.
+function themeStructure(variant) {
+  const errors = [];
+  const { raw } = variant;
+  const report = (at, message) => errors.push(`${jsonPath(at)}: ${message}`);
+
+  if (!THEME_TYPES.includes(raw.type)) {
+    report(['type'], `"${raw.type}" is not one of ${THEME_TYPES.join(', ')}`);
+  }
+  if (raw.include !== undefined && !fs.existsSync(path.join(path.dirname(variant.file), String(raw.include)))) {
+    report(['include'], `${raw.include} does not exist`);
+  }
+
+  for (const [key, color] of Object.entries(raw.colors || {})) {
+    if (typeof color !== 'string' || !HEX_COLOR.test(color)) {
+      report(['colors', key], `${JSON.stringify(color)} is not a hex color`);
+    }
+  }
+
+  (raw.tokenColors || []).forEach((rule, i) => {
+    const { settings } = rule;
+    if (!settings || typeof settings !== 'object' || Array.isArray(settings)) {
+      report(['tokenColors', i], 'rule has no settings object');
+      return;
+    }
+    for (const key of Object.keys(settings)) {
+      if (!TOKEN_SETTINGS_KEYS.includes(key)) {
+        report(['tokenColors', i, 'settings', key], `VS Code ignores "${key}", expected ${TOKEN_SETTINGS_KEYS.join(', ')}`);
+      }
+    }
+  });
+
+  for (const at of duplicateKeys(variant.text)) {
+    if (at[0] !== 'semanticTokenColors') {
+      report(at, 'key is defined more than once');
+    }
+  }
+
+  for (const [i, grammar] of readJSON(path.join(root, 'package.json')).contributes.grammars.entries()) {
+    const at = `package.json ${jsonPath(['contributes', 'grammars', i])}`;
+    const file = path.join(root, grammar.path);
+    if (!fs.existsSync(file)) {
+      errors.push(`${at}.path: ${grammar.path} does not exist`);
+    } else if (readJSON(file).scopeName !== grammar.scopeName) {
+      errors.push(`${at}.scopeName: "${grammar.scopeName}" but ${grammar.path} declares "${readJSON(file).scopeName}"`);
+    }
+  }
+
+  return { errors, warnings: [], notes: [] };
+}
+
+// The workbench colors in REQUIRED_COLORS and all sixteen ANSI colors.
+function requiredColors(variant) {
+  const errors = [...REQUIRED_COLORS, ...ANSI_COLORS]
+    .filter((key) => !variant.theme.colors[key])
+    .map((key) => `${key} is not defined`);
+  return { errors, warnings: [], notes: [] };
+}
+
+// tokenColors rules: every rule needs a scope, every selector must parse,
+// colors must be hex and fontStyle a list of known styles. VS Code drops
+// a broken selector or setting without telling anyone. Rules that set
+// nothing, or repeat a selector, are only warned about.
+function tokenScopes(variant) {
+  const errors = [];
+  const warnings = [];
+
+  (variant.raw.tokenColors || []).forEach((rule, i) => {
+    const at = jsonPath(['tokenColors', i]) + (rule.name ? ` (${rule.name})` : '');
+    const scopes = typeof rule.scope === 'string' ? rule.scope.split(',') : rule.scope;
+    if (!Array.isArray(scopes) || !scopes.length) {
+      errors.push(`${at}: no scope`);
+    } else {
+      const seen = new Set();
+      for (const selector of scopes) {
+        const trimmed = typeof selector === 'string' ? selector.trim() : selector;
+        if (typeof trimmed !== 'string' || !SCOPE_SELECTOR.test(trimmed)) {
+          errors.push(`${at}: ${JSON.stringify(selector)} is not a valid scope selector`);
+        } else if (seen.has(trimmed)) {
+          warnings.push(`${at}: "${trimmed}" is listed more than once`);
+        }
+        seen.add(trimmed);
+      }
+    }
+
+    const settings = rule.settings || {};
+    for (const key of ['foreground', 'background']) {
+      if (key in settings && !(typeof settings[key] === 'string' && HEX_COLOR.test(settings[key]))) {
+        errors.push(`${at}: ${key} ${JSON.stringify(settings[key])} is not a hex color`);
+      }
+    }
+    if ('fontStyle' in settings && !(typeof settings.fontStyle === 'string' && FONT_STYLE.test(settings.fontStyle))) {
+      errors.push(`${at}: invalid fontStyle ${JSON.stringify(settings.fontStyle)}`);
+    }
+    if (!Object.keys(settings).length) {
+      warnings.push(`${at}: settings are empty`);
+    }
+  });
+
+  return { errors, warnings, notes: [] };
+}
+
+// WCAG relative luminance of a #rrggbb color, ignoring alpha.
+function luminance(color) {
+  const [r, g, b] = [1, 3, 5].map((i) => {
+    const channel = parseInt(color.slice(i, i + 2), 16) / 255;
+    return channel <= 0.03928 ? channel / 12.92 : ((channel + 0.055) / 1.055) ** 2.4;
+  });
+  return 0.2126 * r + 0.7152 * g + 0.0722 * b;
+}
+
+// The foreground TextMate would pick for a single scope: the most specific
+// matching selector wins and later rules win ties. Descendant selectors are
+// skipped, since a role is looked up without any parent scopes.
+function scopeForeground(theme, scope) {
+  let best = { depth: 0, color: theme.colors['editor.foreground'] };
+  for (const rule of theme.tokenColors) {
+    const selectors = Array.isArray(rule.scope) ? rule.scope : String(rule.scope || '').split(',');
+    for (const selector of selectors.map((s) => s.trim())) {
+      const matches = selector && !selector.includes(' ') && (scope === selector || scope.startsWith(`${selector}.`));
+      if (matches && rule.settings.foreground && selector.split('.').length >= best.depth) {
+        best = { depth: selector.split('.').length, color: rule.settings.foreground };
+      }
+    }
+  }
+  return best.color;
+}
+
+// Brightness separation between the token roles most often confused. Fails
+// when a pair in LUMINANCE_PAIRS is closer than MIN_LUMINANCE_DELTA, and
+// notes the closest pairs among all roles.
+function tokenLuminance(variant) {
+  const errors = [];
+  const roles = {};
+  for (const [role, scope] of Object.entries(TOKEN_ROLES)) {
+    const color = scopeForeground(variant.theme, scope);
+    roles[role] = { color, luminance: luminance(opaque(color)) };
+  }
+  const delta = (a, b) => Math.abs(roles[a].luminance - roles[b].luminance);
+  const describe = (a, b) =>
+    `${a} ${roles[a].color} vs ${b} ${roles[b].color}: luminance delta ${delta(a, b).toFixed(3)}`;
+
+  for (const [a, b] of LUMINANCE_PAIRS) {
+    if (delta(a, b) < MIN_LUMINANCE_DELTA) {
+      errors.push(`${describe(a, b)} is below ${MIN_LUMINANCE_DELTA}`);
+    }
+  }
+
+  const names = Object.keys(roles);
+  const pairs = names.flatMap((a, i) => names.slice(i + 1).map((b) => [a, b]));
+  const notes = pairs
+    .filter(([a, b]) => opaque(roles[a].color) !== opaque(roles[b].color))
+    .sort((x, y) => delta(...x) - delta(...y))
+    .slice(0, 5)
+    .map(([a, b]) => `closest: ${describe(a, b)}`);
+
+  return { errors, warnings: [], notes };
+}
+
+// A #rrggbbaa color composited over an opaque background, as VS Code
+// draws it. Opaque colors are returned unchanged.
+function blend(color, background) {
+  const full = color.length < 7 ? expandShortHex(color) : color;
+  if (full.length !== 9) {
+    return opaque(full);
+  }
+  const alpha = parseInt(full.slice(7, 9), 16) / 255;
+  const hex = [1, 3, 5].map((i) => {
+    const channel = Math.round(parseInt(full.slice(i, i + 2), 16) * alpha + parseInt(background.slice(i, i + 2), 16) * (1 - alpha));
+    return channel.toString(16).padStart(2, '0');
+  });
+  return `#${hex.join('')}`;
+}
+
+function contrastRatio(a, b) {
+  const [x, y] = [luminance(a), luminance(b)].sort((m, n) => n - m);
+  return (x + 0.05) / (y + 0.05);
+}
+
+// Foreground/background contrast for the pairs in CONTRAST_PAIRS and for
+// every token role against the editor background. Translucent foregrounds
+// are composited over their background first. Notes the lowest ratios.
+function contrast(variant) {
+  const errors = [];
+  const { colors } = variant.theme;
+  const pairs = CONTRAST_PAIRS.filter(([fg, bg]) => colors[fg] && colors[bg]).map(([fg, bg, min]) => [
+    fg, colors[fg], bg, colors[bg], min,
+  ]);
+  for (const [role, scope] of Object.entries(TOKEN_ROLES)) {
+    pairs.push([`${role} token`, scopeForeground(variant.theme, scope), 'editor.background', colors['editor.background'], MIN_TEXT_CONTRAST]);
+  }
+
+  const results = pairs.map(([fg, fgColor, bg, bgColor, min]) => {
+    const background = opaque(bgColor.length < 7 ? expandShortHex(bgColor) : bgColor);
+    const ratio = contrastRatio(blend(fgColor, background), background);
+    return { ratio, min, text: `${fg} ${fgColor} on ${bg} ${bgColor}: ${ratio.toFixed(2)}:1` };
+  });
+  for (const { ratio, min, text } of results) {
+    if (ratio < min) {
+      errors.push(`${text} is below ${min}:1`);
+    }
+  }
+  const notes = [...results]
+    .sort((a, b) => a.ratio / a.min - b.ratio / b.min)
+    .slice(0, 5)
+    .map(({ text, min }) => `lowest: ${text} (minimum ${min}:1)`);
+
+  return { errors, warnings: [], notes };
+}
+
+function colorUsage(theme) {
+  const usage = new Map();
+  const count = (color) => {
+    if (typeof color === 'string' && HEX_COLOR.test(color)) {
+      const key = opaque(color.length < 7 ? expandShortHex(color) : color);
+      usage.set(key, (usage.get(key) || 0) + 1);
+    }
+  };
+  Object.values(theme.colors).forEach(count);
+  for (const rule of theme.tokenColors) {
+    count(rule.settings.foreground);
+    count(rule.settings.background);
+  }
+  for (const value of Object.values(theme.semanticTokenColors)) {
+    count(typeof value === 'string' ? value : value.foreground);
+  }
+  return usage;
+}
+
+function expandShortHex(color) {
+  return `#${[...color.slice(1)].map((c) => c + c).join('')}`;
+}
+
+function rgbDistance(a, b) {
+  const channels = (color) => [1, 3, 5].map((i) => parseInt(color.slice(i, i + 2), 16));
+  const [x, y] = [channels(a), channels(b)];
+  return Math.hypot(x[0] - y[0], x[1] - y[1], x[2] - y[2]);
+}
+
+// Palette discipline: fails when a variant uses more distinct colors than
+// its budget. Notes the usage histogram and colors close enough to merge.
+function colorBudget(variant) {
+  const errors = [];
+  const usage = colorUsage(variant.theme);
+  const budget = COLOR_BUDGET + (variant.raw.include ? BRACKET_PALETTE_ALLOWANCE : 0);
+  if (usage.size > budget) {
+    errors.push(`${usage.size} distinct colors, budget is ${budget}`);
+  }
+
+  const histogram = [...usage].sort((a, b) => b[1] - a[1] || a[0].localeCompare(b[0]));
+  const notes = [
+    `${usage.size}/${budget} distinct colors`,
+    ...histogram.map(([color, uses]) => `${color} ${'*'.repeat(Math.min(uses, 60))} ${uses}`),
+  ];
+  const colors = histogram.map(([color]) => color);
+  colors.forEach((a, i) => {
+    for (const b of colors.slice(i + 1)) {
+      if (rgbDistance(a, b) < NEAR_DUPLICATE_DISTANCE) {
+        notes.push(`near-duplicate: ${a} and ${b}`);
+      }
+    }
+  });
+
+  return { errors, warnings: [], notes };
+}
+
+// Every check, in the order the completeness report lists them.
+const CHECKS = [
+  { name: 'theme structure', run: themeStructure },
+  { name: 'required workbench colors', run: requiredColors },
+  { name: 'tokenColors scopes and settings', run: tokenScopes },
+  { name: 'bracket palette', run: bracketPalette },
+  { name: 'bracket colorization and guides', run: bracketGuides },
+  { name: 'semanticTokenColors selectors', run: semanticSelectors },
+  { name: 'foreground/background contrast', run: contrast },
+  { name: 'token luminance separation', run: tokenLuminance },
+  { name: 'color budget', run: colorBudget },
+];
+
+module.exports = {
+  CHECKS,
+  BRACKET_LEVELS,
+  COLOR_BUDGET,
+  HEX_COLOR,
+  MIN_LUMINANCE_DELTA,
+  MIN_TEXT_CONTRAST,
+  MIN_UI_CONTRAST,
+  opaque,
+  luminance,
+  scopeForeground,
+  colorUsage,
+  themeStructure,
+  requiredColors,
+  tokenScopes,
+  bracketPalette,
+  bracketGuides,
+  semanticSelectors,
+  contrast,
+  tokenLuminance,
+  colorBudget,
+};
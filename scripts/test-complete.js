@@ -0,0 +1,32 @@
+'use strict';
+
+// Release gate: runs every check in scripts/checks.js against every
+// contributed variant and prints one pass/fail report per variant. Exits
+// non-zero unless every check passes for every variant.
+
+const { loadVariants } = require('./theme');
+const { CHECKS } = require('./checks');
+
+let failed = 0;
+let total = 0;
+
+for (const variant of loadVariants()) {
+  const results = CHECKS.map((check) => ({ name: check.name, ...check.run(variant) }));
+  const variantFailed = results.some((result) => result.errors.length);
+  console.log(`${variant.label}: ${variantFailed ? 'FAIL' : 'PASS'}`);
+
+  for (const { name, errors, warnings } of results) {
+    const suffix = warnings.length ? ` (${warnings.length} warning${warnings.length > 1 ? 's' : ''})` : '';
+    console.log(`  ${errors.length ? 'FAIL' : 'PASS'}  ${name}${suffix}`);
+    errors.forEach((error) => console.log(`          error: ${error}`));
+    warnings.forEach((warning) => console.log(`          warning: ${warning}`));
+    total++;
+    if (errors.length) {
+      failed++;
+    }
+  }
+  console.log('');
+}
+
+console.log(`${total - failed}/${total} checks passed`);
+process.exitCode = failed ? 1 : 0;
@@ -0,0 +1,108 @@
+'use strict';
+
+// Loads the contributed themes the same way VS Code does: every variant
+// listed in package.json is read and its "include" chain is merged in, so
+// checks see exactly the colors a user gets after picking the variant.
+
+const fs = require('node:fs');
+const path = require('node:path');
+
+const root = path.join(__dirname, '..');
+
+function readJSON(file) {
+  return JSON.parse(fs.readFileSync(file, 'utf8'));
+}
+
+// JSON.parse keeps the last of two identical keys without complaint, and
+// VS Code does the same, so duplicates have to be found in the source text.
+// Returns the path of every repeated key. The text must be valid JSON.
+function duplicateKeys(text) {
+  const duplicates = [];
+  let i = 0;
+
+  const skipSpace = () => {
+    while (/\s/.test(text[i])) {
+      i++;
+    }
+  };
+  const string = () => {
+    const start = i++;
+    while (text[i] !== '"') {
+      i += text[i] === '\\' ? 2 : 1;
+    }
+    i++;
+    return JSON.parse(text.slice(start, i));
+  };
+  const value = (at) => {
+    skipSpace();
+    if (text[i] === '{' || text[i] === '[') {
+      const isObject = text[i] === '{';
+      const close = isObject ? '}' : ']';
+      const keys = new Set();
+      let index = 0;
+      i++;
+      skipSpace();
+      while (text[i] !== close) {
+        let key = index++;
+        if (isObject) {
+          key = string();
+          if (keys.has(key)) {
+            duplicates.push([...at, key]);
+          }
+          keys.add(key);
+          skipSpace();
+          i++;
+        }
+        value([...at, key]);
+        skipSpace();
+        if (text[i] === ',') {
+          i++;
+          skipSpace();
+        }
+      }
+      i++;
+    } else if (text[i] === '"') {
+      string();
+    } else {
+      while (i < text.length && !/[\s,\]}]/.test(text[i])) {
+        i++;
+      }
+    }
+  };
+
+  value([]);
+  return duplicates;
+}
+
+function resolveTheme(file) {
+  const raw = readJSON(file);
+  const own = {
+    colors: raw.colors || {},
+    tokenColors: raw.tokenColors || [],
+    semanticTokenColors: raw.semanticTokenColors || {},
+  };
+  if (!raw.include) {
+    return { ...raw, ...own };
+  }
+
+  const base = resolveTheme(path.join(path.dirname(file), raw.include));
+  return {
+    ...base,
+    ...raw,
+    colors: { ...base.colors, ...own.colors },
+    tokenColors: [...base.tokenColors, ...own.tokenColors],
+    semanticTokenColors: { ...base.semanticTokenColors, ...own.semanticTokenColors },
+  };
+}
+
+function loadVariant(file, label) {
+  const text = fs.readFileSync(file, 'utf8');
+  return { label, file, text, raw: JSON.parse(text), theme: resolveTheme(file) };
+}
+
+function loadVariants() {
+  const pkg = readJSON(path.join(root, 'package.json'));
+  return pkg.contributes.themes.map((entry) => loadVariant(path.join(root, entry.path), entry.label));
+}
+
+module.exports = { root, readJSON, duplicateKeys, resolveTheme, loadVariant, loadVariants };
@@ -0,0 +1,33 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { bracketGuides } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: bracket colorization and guides`, () => {
+    assert.deepEqual(bracketGuides(variant).errors, []);
+  });
+}
+
+test('bracket guide check rejects missing levels and mismatched guides', () => {
+  const colors = {};
+  for (const n of [1, 2, 3, 4, 5]) {
+    colors[`editorBracketHighlight.foreground${n}`] = '#7aa2f7';
+    colors[`editorBracketPairGuide.background${n}`] = '#7aa2f74d';
+    colors[`editorBracketPairGuide.activeBackground${n}`] = '#7aa2f7';
+  }
+  colors['editorBracketPairGuide.activeBackground2'] = '#bb9af7';
+  colors['editorBracketPairGuide.background3'] = '#7aa2f7';
+
+  assert.deepEqual(bracketGuides({ theme: { colors } }).errors, [
+    'editorBracketPairGuide.activeBackground2 #bb9af7 does not match editorBracketHighlight.foreground2 #7aa2f7',
+    'editorBracketPairGuide.background3 #7aa2f7 should be translucent',
+    'editorBracketHighlight.foreground6 is not defined',
+    'editorBracketPairGuide.background6 is not defined',
+    'editorBracketPairGuide.activeBackground6 is not defined',
+    'editorBracketHighlight.unexpectedBracket.foreground is not defined',
+  ]);
+});
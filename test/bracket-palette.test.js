@@ -0,0 +1,37 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { bracketPalette } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: bracket palette`, () => {
+    assert.deepEqual(bracketPalette(variant).errors, []);
+  });
+}
+
+test('bracket palette check rejects non-bracket overrides and levels too close together', () => {
+  const colors = {
+    'editor.background': '#000000',
+    'editorBracketHighlight.foreground1': '#7aa2f7',
+    'editorBracketHighlight.foreground2': '#7aa2f780',
+    'editorBracketHighlight.unexpectedBracket.foreground': '#7aa2f7',
+    'editorBracketHighlight.foreground3': '#c8d3f5',
+    'editorBracketHighlight.foreground4': '#b4c9fb',
+  };
+  const variant = {
+    raw: { include: './base.json', colors, tokenColors: [] },
+    theme: { colors },
+  };
+
+  assert.deepEqual(bracketPalette(variant).errors, [
+    'level 1 #7aa2f7 and level 2 #7aa2f780 are only 0.0 apart (minimum 32)',
+    'level 1 #7aa2f7 and the unexpected-bracket color #7aa2f7 are only 0.0 apart (minimum 32)',
+    'level 2 #7aa2f780 and the unexpected-bracket color #7aa2f7 are only 0.0 apart (minimum 32)',
+    'level 3 #c8d3f5 and level 4 #b4c9fb are only 23.2 apart (minimum 32)',
+    'palette variant defines "tokenColors"',
+    'palette variant overrides non-bracket key editor.background',
+  ]);
+});
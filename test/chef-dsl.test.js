@@ -0,0 +1,21 @@
+'use strict';
+
+// The Chef recipe in examples/test.chef.rb: the Ruby grammar has no Chef
+// scopes, so ruby-chef-dsl.injection.json adds them for resource names and
+// node attribute lookups. They must not fall back to the plain Ruby colors.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: Chef resources and attribute keys have their own colors`, () => {
+    const color = (scope) => scopeForeground(variant.theme, scope);
+    assert.equal(color('entity.name.type.resource.chef'), color('entity.name.type.resource.puppet'));
+    assert.equal(color('variable.other.property.attribute.chef'), color('variable.other.key.puppet'));
+    assert.notEqual(color('variable.other.property.attribute.chef'), color('string.quoted.single.ruby'));
+    assert.notEqual(color('entity.name.type.resource.chef'), color('entity.name.function.ruby'));
+  });
+}
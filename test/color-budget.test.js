@@ -0,0 +1,15 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { colorBudget } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: color budget`, (t) => {
+    const { errors, notes } = colorBudget(variant);
+    notes.forEach((note) => t.diagnostic(note));
+    assert.deepEqual(errors, []);
+  });
+}
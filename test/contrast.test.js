@@ -0,0 +1,32 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { contrast } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: foreground/background contrast`, (t) => {
+    const { errors, notes } = contrast(variant);
+    notes.forEach((note) => t.diagnostic(note));
+    assert.deepEqual(errors, []);
+  });
+}
+
+test('contrast check composites translucent text and applies the UI minimum', () => {
+  const colors = {
+    'editor.background': '#1a1b26',
+    'editor.foreground': '#c8d3f5',
+    'editorLineNumber.foreground': '#3d4b73',
+    'tab.inactiveForeground': '#c8d3f540',
+    'tab.inactiveBackground': '#151a24',
+  };
+  const tokenColors = [{ scope: 'constant.numeric', settings: { foreground: '#5c7287' } }];
+
+  assert.deepEqual(contrast({ theme: { colors, tokenColors } }).errors, [
+    'editorLineNumber.foreground #3d4b73 on editor.background #1a1b26: 2.00:1 is below 3:1',
+    'tab.inactiveForeground #c8d3f540 on tab.inactiveBackground #151a24: 1.91:1 is below 3:1',
+    'number token #5c7287 on editor.background #1a1b26: 3.43:1 is below 4.5:1',
+  ]);
+});
@@ -0,0 +1,19 @@
+'use strict';
+
+// The composite literals in examples/test.go: struct keys (ID:, Name:) are
+// property-scoped by the Go grammar, while map keys are ordinary string
+// and numeric literals. A struct key must not blend into either.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: struct literal keys differ from string and number map keys`, () => {
+    const key = scopeForeground(variant.theme, 'variable.other.property.go');
+    assert.notEqual(key, scopeForeground(variant.theme, 'string.quoted.double.go'));
+    assert.notEqual(key, scopeForeground(variant.theme, 'constant.numeric.decimal.go'));
+  });
+}
@@ -0,0 +1,20 @@
+'use strict';
+
+// The concurrency tint belongs to go-concurrency-types.injection.json's own
+// scopes. The Go grammar's keyword.channel.go must keep the keyword color.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: concurrency tint stays on the injection scopes`, () => {
+    const color = (scope) => scopeForeground(variant.theme, scope);
+    const tint = color('support.type.concurrency.go');
+    assert.equal(color('keyword.channel.concurrency.go'), tint);
+    assert.equal(color('keyword.channel.go'), color('keyword'));
+    assert.notEqual(color('keyword.channel.go'), tint);
+  });
+}
@@ -0,0 +1,19 @@
+'use strict';
+
+// %w is a plain placeholder everywhere in the Go grammar. Only the format
+// string of fmt.Errorf, retokenized by go-error-wrapping.injection.json,
+// gives it the wrap scope, and only there may it stand out from %v and %s.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: %w stands out only inside fmt.Errorf`, () => {
+    const verb = scopeForeground(variant.theme, 'constant.other.placeholder.go');
+    assert.notEqual(scopeForeground(variant.theme, 'constant.other.placeholder.wrap.go'), verb);
+    assert.notEqual(verb, scopeForeground(variant.theme, 'string.quoted.double.go'));
+  });
+}
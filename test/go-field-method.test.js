@@ -0,0 +1,32 @@
+'use strict';
+
+// Counter in examples/test.go has a field and a method sharing a name
+// (count and Count). Field access must not take the method color, neither
+// through TextMate scopes nor through gopls semantic tokens.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+const semanticForeground = (theme, selector) => {
+  const style = theme.semanticTokenColors[selector];
+  return typeof style === 'string' ? style : style.foreground;
+};
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: Go fields and methods resolve to different colors`, () => {
+    const { theme } = variant;
+    const methods = ['entity.name.function.go', 'support.function.go'].map((scope) => scopeForeground(theme, scope));
+    for (const scope of ['variable.other.property.go', 'variable.other.property.field.go']) {
+      for (const method of methods) {
+        assert.notEqual(scopeForeground(theme, scope), method, scope);
+      }
+    }
+
+    const property = semanticForeground(theme, 'property');
+    assert.notEqual(property, semanticForeground(theme, 'method'));
+    assert.notEqual(property, semanticForeground(theme, 'function'));
+  });
+}
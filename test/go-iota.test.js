@@ -0,0 +1,52 @@
+'use strict';
+
+// Colors the iota fixture (examples/iota.go) resolves to, checked against a
+// snapshot so changes to the constant, enum and operator rules are caught.
+// There is no TextMate tokenizer here, so each construct is listed with the
+// scope the Go grammar gives it. Refresh the snapshot on purpose with
+// `npm run test:update-snapshots`.
+
+const fs = require('node:fs');
+const path = require('node:path');
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+const SNAPSHOT = path.join(__dirname, 'snapshots', 'go-iota.json');
+
+const CONSTRUCTS = {
+  'const keyword': 'keyword.const.go',
+  iota: 'constant.language.iota.go',
+  'skipped value _': 'variable.language.blank.go',
+  'constant name (Monday, PermRead, KB)': 'variable.other.constant.go',
+  'enum type (Weekday, Permission, Level)': 'entity.name.type.go',
+  'shift operator <<': 'keyword.operator.arithmetic.bitwise.go',
+  'bitwise or |': 'keyword.operator.arithmetic.bitwise.go',
+  'shift amount (1, 10)': 'constant.numeric.decimal.go',
+  'string enum value ("admin")': 'string.quoted.double.go',
+  'String() method name': 'entity.name.function.go',
+};
+
+const [main] = loadVariants();
+const actual = {};
+for (const [construct, scope] of Object.entries(CONSTRUCTS)) {
+  actual[construct] = { scope, color: scopeForeground(main.theme, scope) };
+}
+
+if (process.env.UPDATE_SNAPSHOTS) {
+  fs.writeFileSync(SNAPSHOT, `${JSON.stringify(actual, null, 2)}\n`);
+}
+
+test(`${main.label}: iota fixture colors match the snapshot`, () => {
+  assert.deepEqual(actual, JSON.parse(fs.readFileSync(SNAPSHOT, 'utf8')));
+});
+
+test(`${main.label}: iota constants stay distinct from their values and operators`, () => {
+  const color = (construct) => actual[construct].color;
+  assert.notEqual(color('iota'), color('constant name (Monday, PermRead, KB)'));
+  assert.notEqual(color('constant name (Monday, PermRead, KB)'), color('shift operator <<'));
+  assert.notEqual(color('skipped value _'), color('constant name (Monday, PermRead, KB)'));
+  assert.notEqual(color('String() method name'), color('enum type (Weekday, Permission, Level)'));
+});
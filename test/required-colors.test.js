@@ -0,0 +1,25 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { requiredColors } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: required workbench colors`, () => {
+    assert.deepEqual(requiredColors(variant).errors, []);
+  });
+}
+
+test('required colors check lists every missing key', () => {
+  const [main] = loadVariants();
+  const colors = { ...main.theme.colors };
+  delete colors['sideBar.background'];
+  delete colors['terminal.ansiBrightCyan'];
+
+  assert.deepEqual(requiredColors({ theme: { colors } }).errors, [
+    'sideBar.background is not defined',
+    'terminal.ansiBrightCyan is not defined',
+  ]);
+});
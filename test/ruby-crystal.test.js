@@ -0,0 +1,23 @@
+'use strict';
+
+// examples/test.rb and test.cr share Ruby-like syntax but not scopes.
+// Crystal type annotations (: Int32) must use the type color, and Ruby
+// symbols their own color, without either rule leaking into the other.
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { scopeForeground } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: Crystal type annotations and Ruby symbols keep separate colors`, () => {
+    const color = (scope) => scopeForeground(variant.theme, scope);
+    const type = color('entity.name.type');
+    const symbol = color('constant.other.symbol');
+    assert.equal(color('meta.type-annotation.crystal'), type);
+    assert.equal(color('entity.name.type.crystal'), type);
+    assert.equal(color('constant.other.symbol.ruby'), symbol);
+    assert.notEqual(color('constant.other.symbol.ruby'), color('meta.type-annotation.crystal'));
+  });
+}
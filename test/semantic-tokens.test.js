@@ -0,0 +1,33 @@
+'use strict';
+
+const path = require('node:path');
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariant, loadVariants } = require('../scripts/theme');
+const { semanticSelectors } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: semanticTokenColors`, (t) => {
+    const { errors, warnings } = semanticSelectors(variant);
+    warnings.forEach((warning) => t.diagnostic(`warning: ${warning}`));
+    assert.deepEqual(errors, []);
+  });
+}
+
+test('semanticTokenColors check rejects the malformed fixture', () => {
+  const fixture = loadVariant(path.join(__dirname, 'fixtures', 'malformed-semantic-tokens.json'), 'fixture');
+  const { errors, warnings } = semanticSelectors(fixture);
+
+  assert.deepEqual(errors, [
+    '"function:python.decorator" is not a valid selector',
+    '"variable..readonly" is not a valid selector',
+    '"namespace": "7dcfff" is not a hex color',
+    '"property": unknown style key "font"',
+    '"type" is defined more than once',
+  ]);
+  assert.deepEqual(warnings, [
+    '"fucntion": unknown token type "fucntion", did you mean "function"?',
+    '"method.readonyl": unknown modifier "readonyl", did you mean "readonly"?',
+  ]);
+});
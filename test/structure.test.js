@@ -0,0 +1,37 @@
+'use strict';
+
+const path = require('node:path');
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { root, loadVariants } = require('../scripts/theme');
+const { themeStructure } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: theme structure`, () => {
+    assert.deepEqual(themeStructure(variant).errors, []);
+  });
+}
+
+test('theme structure check reports each mistake with its JSON path', () => {
+  const text = `{
+    "type": "darker",
+    "include": "./missing.json",
+    "colors": {},
+    "tokenColors": [
+      { "scope": "string", "settings": { "foreground": "#9ece6a", "fontstyle": "italic" } },
+      { "scope": "comment" }
+    ],
+    "colors": { "editor.background": "1a1b26", "editor.foreground": "#c8d3f5" }
+  }`;
+  const variant = { file: path.join(root, 'themes', 'broken.json'), text, raw: JSON.parse(text) };
+
+  assert.deepEqual(themeStructure(variant).errors, [
+    'type: "darker" is not one of dark, light, hc-dark, hc-light',
+    'include: ./missing.json does not exist',
+    'colors["editor.background"]: "1a1b26" is not a hex color',
+    'tokenColors[0].settings.fontstyle: VS Code ignores "fontstyle", expected foreground, background, fontStyle',
+    'tokenColors[1]: rule has no settings object',
+    'colors: key is defined more than once',
+  ]);
+});
@@ -0,0 +1,15 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { tokenLuminance } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: token luminance separation`, (t) => {
+    const { errors, notes } = tokenLuminance(variant);
+    notes.forEach((note) => t.diagnostic(note));
+    assert.deepEqual(errors, []);
+  });
+}
@@ -0,0 +1,36 @@
+'use strict';
+
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { loadVariants } = require('../scripts/theme');
+const { tokenScopes } = require('../scripts/checks');
+
+for (const variant of loadVariants()) {
+  test(`${variant.label}: tokenColors scopes and settings`, (t) => {
+    const { errors, warnings } = tokenScopes(variant);
+    warnings.forEach((warning) => t.diagnostic(warning));
+    assert.deepEqual(errors, []);
+  });
+}
+
+test('tokenColors check rejects broken selectors and settings', () => {
+  const tokenColors = [
+    { name: 'Strings', scope: ['string', 'string..quoted', 'string'], settings: { foreground: '#9ece6a' } },
+    { name: 'Comments', scope: 'comment, source.go -comment', settings: { foreground: 'green', fontStyle: 'oblique' } },
+    { settings: { background: '#1a1b26' } },
+    { name: 'Empty', scope: 'meta.embedded', settings: {} },
+  ];
+  const { errors, warnings } = tokenScopes({ raw: { tokenColors } });
+
+  assert.deepEqual(errors, [
+    'tokenColors[0] (Strings): "string..quoted" is not a valid scope selector',
+    'tokenColors[1] (Comments): foreground "green" is not a hex color',
+    'tokenColors[1] (Comments): invalid fontStyle "oblique"',
+    'tokenColors[2]: no scope',
+  ]);
+  assert.deepEqual(warnings, [
+    'tokenColors[0] (Strings): "string" is listed more than once',
+    'tokenColors[3] (Empty): settings are empty',
+  ]);
+});